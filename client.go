@@ -0,0 +1,295 @@
+//
+// Copyright 2015, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+const (
+	libraryVersion = "0.1.0"
+	defaultBaseURL = "https://gitlab.com/api/v3/"
+	userAgent      = "go-gitlab/" + libraryVersion
+)
+
+// A Client manages communication with the GitLab API.
+type Client struct {
+	// HTTP client used to communicate with the API.
+	client *http.Client
+
+	// Base URL for API requests. Should always be specified with a trailing
+	// slash.
+	BaseURL *url.URL
+
+	// User agent used when communicating with the GitLab API.
+	UserAgent string
+
+	// Token used to make authenticated API calls.
+	token string
+
+	// Services used for talking to different parts of the GitLab API.
+	Issues        *IssuesService
+	Labels        *LabelsService
+	MergeRequests *MergeRequestsService
+}
+
+// NewClient returns a new GitLab API client. If a nil httpClient is
+// provided, http.DefaultClient will be used.
+func NewClient(httpClient *http.Client, token string) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent, token: token}
+	c.Issues = &IssuesService{client: c}
+	c.Labels = &LabelsService{client: c}
+	c.MergeRequests = &MergeRequestsService{client: c}
+
+	return c
+}
+
+// NewRequest creates an API request. A relative URL can be provided in
+// urlStr, in which case it is resolved relative to the BaseURL of the
+// Client. Relative URLs should always be specified without a preceding
+// slash.
+func (c *Client) NewRequest(method, urlStr string, opt interface{}) (*http.Request, error) {
+	rel, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	u := c.BaseURL.ResolveReference(rel)
+
+	var body io.Reader
+	if opt != nil {
+		q, err := query.Values(opt)
+		if err != nil {
+			return nil, err
+		}
+		if method == "POST" || method == "PUT" {
+			body = bytes.NewBufferString(q.Encode())
+		} else {
+			u.RawQuery = q.Encode()
+		}
+	}
+
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	if c.token != "" {
+		req.Header.Set("PRIVATE-TOKEN", c.token)
+	}
+
+	return req, nil
+}
+
+// Response is a GitLab API response. This wraps the standard http.Response
+// returned from GitLab and provides convenient access to the pagination
+// values found in the X-Total*, X-Page*, X-Next-Page, X-Prev-Page and Link
+// headers.
+type Response struct {
+	*http.Response
+
+	// These fields provide the page values for paginating through a set of
+	// results. Any or all of these may be set to the zero value for
+	// responses that are not part of a paginated set, or for which there
+	// are no additional pages.
+	CurrentPage int
+	PerPage     int
+	NextPage    int
+	PrevPage    int
+	FirstPage   int
+	LastPage    int
+
+	TotalItems int
+	TotalPages int
+}
+
+// newResponse creates a new Response for the provided http.Response.
+func newResponse(r *http.Response) *Response {
+	response := &Response{Response: r}
+	response.populatePageValues()
+	return response
+}
+
+const (
+	xTotal      = "X-Total"
+	xTotalPages = "X-Total-Pages"
+	xPerPage    = "X-Per-Page"
+	xPage       = "X-Page"
+	xNextPage   = "X-Next-Page"
+	xPrevPage   = "X-Prev-Page"
+)
+
+// populatePageValues sets the NextPage and PrevPage fields of the Response
+// from the X-Next-Page and X-Prev-Page headers, the FirstPage and LastPage
+// fields by parsing the HTTP Link response header, and the TotalItems and
+// TotalPages fields from the X-Total and X-Total-Pages headers.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/README.html#pagination
+func (r *Response) populatePageValues() {
+	if totalItems := r.Response.Header.Get(xTotal); totalItems != "" {
+		r.TotalItems, _ = strconv.Atoi(totalItems)
+	}
+	if totalPages := r.Response.Header.Get(xTotalPages); totalPages != "" {
+		r.TotalPages, _ = strconv.Atoi(totalPages)
+	}
+	if nextPage := r.Response.Header.Get(xNextPage); nextPage != "" {
+		r.NextPage, _ = strconv.Atoi(nextPage)
+	}
+	if prevPage := r.Response.Header.Get(xPrevPage); prevPage != "" {
+		r.PrevPage, _ = strconv.Atoi(prevPage)
+	}
+	if page := r.Response.Header.Get(xPage); page != "" {
+		r.CurrentPage, _ = strconv.Atoi(page)
+	}
+	if perPage := r.Response.Header.Get(xPerPage); perPage != "" {
+		r.PerPage, _ = strconv.Atoi(perPage)
+	}
+
+	if links, ok := r.Response.Header["Link"]; ok && len(links) > 0 {
+		for _, link := range strings.Split(links[0], ",") {
+			segments := strings.Split(strings.TrimSpace(link), ";")
+			if len(segments) < 2 {
+				continue
+			}
+
+			linkURL, err := url.Parse(strings.Trim(segments[0], "<>"))
+			if err != nil {
+				continue
+			}
+			page := linkURL.Query().Get("page")
+			if page == "" {
+				continue
+			}
+
+			for _, segment := range segments[1:] {
+				switch strings.TrimSpace(segment) {
+				case `rel="first"`:
+					r.FirstPage, _ = strconv.Atoi(page)
+				case `rel="last"`:
+					r.LastPage, _ = strconv.Atoi(page)
+				}
+			}
+		}
+	}
+}
+
+// ListOptions specifies the optional parameters to various List methods
+// that support pagination.
+type ListOptions struct {
+	// For paginated result sets, page of results to retrieve.
+	Page int `url:"page,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// Do sends an API request and returns the API response. The API response is
+// JSON decoded and stored in the value pointed to by v, or returned as an
+// error if an API error has occurred. If v implements the io.Writer
+// interface, the raw response body will be written to v, without attempting
+// to first decode it.
+//
+// The provided ctx must be non-nil. If it is canceled or times out, ctx.Err()
+// is returned.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	req = req.WithContext(ctx)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	response := newResponse(resp)
+
+	if err := CheckResponse(resp); err != nil {
+		return response, err
+	}
+
+	if v != nil {
+		if w, ok := v.(io.Writer); ok {
+			io.Copy(w, resp.Body)
+		} else {
+			err = json.NewDecoder(resp.Body).Decode(v)
+			if err == io.EOF {
+				err = nil // ignore EOF errors caused by empty response body
+			}
+		}
+	}
+
+	return response, err
+}
+
+// An ErrorResponse reports one or more errors caused by an API request.
+type ErrorResponse struct {
+	Response *http.Response
+	Message  string `json:"message"`
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("%v %v: %d %s",
+		e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode, e.Message)
+}
+
+// CheckResponse checks the API response for errors, and returns them if present.
+func CheckResponse(r *http.Response) error {
+	switch c := r.StatusCode; {
+	case 200 <= c && c <= 299:
+		return nil
+	case c == http.StatusNotModified:
+		// GitLab returns 304 for idempotent no-ops, e.g. subscribing to a
+		// label the authenticated user is already subscribed to. That's not
+		// an error condition for callers.
+		return nil
+	}
+
+	errorResponse := &ErrorResponse{Response: r}
+	data, err := io.ReadAll(r.Body)
+	if err == nil && data != nil {
+		json.Unmarshal(data, errorResponse)
+	}
+
+	return errorResponse
+}
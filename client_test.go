@@ -0,0 +1,200 @@
+//
+// Copyright 2015, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// setup sets up a test HTTP server along with a gitlab.Client that is
+// configured to talk to that test server. Tests should register handlers on
+// mux which provide mock responses for the API method being tested.
+func setup() (mux *http.ServeMux, client *Client, teardown func()) {
+	mux = http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	client = NewClient(nil, "")
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+
+	return mux, client, server.Close
+}
+
+func testMethod(t *testing.T, r *http.Request, want string) {
+	t.Helper()
+	if got := r.Method; got != want {
+		t.Errorf("request method: %s, want %s", got, want)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	c := NewClient(nil, "token")
+
+	if c.BaseURL.String() != defaultBaseURL {
+		t.Errorf("NewClient BaseURL = %v, want %v", c.BaseURL.String(), defaultBaseURL)
+	}
+	if c.UserAgent != userAgent {
+		t.Errorf("NewClient UserAgent = %v, want %v", c.UserAgent, userAgent)
+	}
+	if c.Labels == nil || c.Issues == nil || c.MergeRequests == nil {
+		t.Fatal("NewClient did not wire up all services")
+	}
+}
+
+func TestNewRequest_getEncodesOptionsAsQuery(t *testing.T) {
+	c := NewClient(nil, "")
+
+	req, err := c.NewRequest("GET", "projects/1/labels", &ListLabelsOptions{ListOptions{Page: 2, PerPage: 10}})
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	if got, want := req.URL.Query().Get("page"), "2"; got != want {
+		t.Errorf("page = %v, want %v", got, want)
+	}
+	if got, want := req.URL.Query().Get("per_page"), "10"; got != want {
+		t.Errorf("per_page = %v, want %v", got, want)
+	}
+}
+
+func TestNewRequest_postEncodesOptionsAsBody(t *testing.T) {
+	c := NewClient(nil, "")
+
+	req, err := c.NewRequest("POST", "projects/1/labels", &CreateLabelOptions{Name: "bug", Color: "#f00"})
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	if req.URL.RawQuery != "" {
+		t.Errorf("POST request should not carry a query string, got %q", req.URL.RawQuery)
+	}
+	if got, want := req.Header.Get("Content-Type"), "application/x-www-form-urlencoded"; got != want {
+		t.Errorf("Content-Type = %v, want %v", got, want)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		t.Fatalf("ParseForm returned error: %v", err)
+	}
+	if got, want := req.Form.Get("name"), "bug"; got != want {
+		t.Errorf("name = %v, want %v", got, want)
+	}
+}
+
+func TestDo_decodesJSONResponse(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	type payload struct {
+		Name string `json:"name"`
+	}
+
+	mux.HandleFunc("/thing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		json.NewEncoder(w).Encode(payload{Name: "bug"})
+	})
+
+	req, err := client.NewRequest("GET", "thing", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	var got payload
+	if _, err := client.Do(context.Background(), req, &got); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if got.Name != "bug" {
+		t.Errorf("Do decoded Name = %v, want %v", got.Name, "bug")
+	}
+}
+
+func TestDo_canceledContextAbortsRequest(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	unblock := make(chan struct{})
+	mux.HandleFunc("/thing", func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	})
+	defer close(unblock)
+
+	req, err := client.NewRequest("GET", "thing", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err = client.Do(ctx, req, nil)
+	if err != context.Canceled {
+		t.Fatalf("Do error = %v, want context.Canceled", err)
+	}
+}
+
+func TestCheckResponse_notModifiedIsNotAnError(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusNotModified}
+	if err := CheckResponse(resp); err != nil {
+		t.Errorf("CheckResponse(304) = %v, want nil", err)
+	}
+}
+
+func TestResponse_populatePageValues(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Next-Page", "3")
+	header.Set("X-Prev-Page", "1")
+	header.Set("X-Page", "2")
+	header.Set("X-Per-Page", "20")
+	header.Set("X-Total", "100")
+	header.Set("X-Total-Pages", "5")
+	header.Set("Link", `<https://gitlab.example.com/api/v3/projects/1/labels?page=1>; rel="first", <https://gitlab.example.com/api/v3/projects/1/labels?page=5>; rel="last"`)
+
+	r := newResponse(&http.Response{Header: header})
+
+	if r.NextPage != 3 {
+		t.Errorf("NextPage = %d, want 3", r.NextPage)
+	}
+	if r.PrevPage != 1 {
+		t.Errorf("PrevPage = %d, want 1", r.PrevPage)
+	}
+	if r.CurrentPage != 2 {
+		t.Errorf("CurrentPage = %d, want 2", r.CurrentPage)
+	}
+	if r.PerPage != 20 {
+		t.Errorf("PerPage = %d, want 20", r.PerPage)
+	}
+	if r.TotalItems != 100 {
+		t.Errorf("TotalItems = %d, want 100", r.TotalItems)
+	}
+	if r.TotalPages != 5 {
+		t.Errorf("TotalPages = %d, want 5", r.TotalPages)
+	}
+	if r.FirstPage != 1 {
+		t.Errorf("FirstPage = %d, want 1", r.FirstPage)
+	}
+	if r.LastPage != 5 {
+		t.Errorf("LastPage = %d, want 5", r.LastPage)
+	}
+}
@@ -0,0 +1,105 @@
+//
+// Copyright 2015, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// IssuesService handles communication with the issue related methods of the
+// GitLab API.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/issues.html
+type IssuesService struct {
+	client *Client
+}
+
+// Issue represents a GitLab issue.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/issues.html
+type Issue struct {
+	ID        int      `json:"id"`
+	IID       int      `json:"iid"`
+	ProjectID int      `json:"project_id"`
+	Title     string   `json:"title"`
+	Labels    []string `json:"labels"`
+}
+
+func (i Issue) String() string {
+	return Stringify(i)
+}
+
+// UpdateIssueLabelsOptions represents the available options for updating the
+// labels that are assigned to an issue.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/issues.html#update-issue
+type UpdateIssueLabelsOptions struct {
+	Labels       []string `url:"labels,comma,omitempty"`
+	AddLabels    []string `url:"add_labels,comma,omitempty"`
+	RemoveLabels []string `url:"remove_labels,comma,omitempty"`
+}
+
+// updateIssueLabels updates the labels assigned to an issue and returns the
+// updated issue.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/issues.html#update-issue
+func (s *IssuesService) updateIssueLabels(ctx context.Context, pid interface{}, issueIID int, opt *UpdateIssueLabelsOptions) (*Issue, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/issues/%d", url.QueryEscape(project), issueIID)
+
+	req, err := s.client.NewRequest("PUT", u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := new(Issue)
+	resp, err := s.client.Do(ctx, req, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, err
+}
+
+// AddLabels adds the given labels to an issue, leaving any existing labels
+// on the issue untouched, and returns the updated issue.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/issues.html#update-issue
+func (s *IssuesService) AddLabels(ctx context.Context, pid interface{}, issueIID int, labels []string) (*Issue, *Response, error) {
+	return s.updateIssueLabels(ctx, pid, issueIID, &UpdateIssueLabelsOptions{AddLabels: labels})
+}
+
+// RemoveLabels removes the given labels from an issue and returns the
+// updated issue.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/issues.html#update-issue
+func (s *IssuesService) RemoveLabels(ctx context.Context, pid interface{}, issueIID int, labels []string) (*Issue, *Response, error) {
+	return s.updateIssueLabels(ctx, pid, issueIID, &UpdateIssueLabelsOptions{RemoveLabels: labels})
+}
+
+// SetLabels replaces an issue's full set of labels with the given labels and
+// returns the updated issue.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/issues.html#update-issue
+func (s *IssuesService) SetLabels(ctx context.Context, pid interface{}, issueIID int, labels []string) (*Issue, *Response, error) {
+	return s.updateIssueLabels(ctx, pid, issueIID, &UpdateIssueLabelsOptions{Labels: labels})
+}
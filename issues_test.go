@@ -0,0 +1,85 @@
+//
+// Copyright 2015, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssuesService_AddLabels(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1/issues/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		r.ParseForm()
+		if got, want := r.Form.Get("add_labels"), "bug,urgent"; got != want {
+			t.Errorf("add_labels = %v, want %v", got, want)
+		}
+		if r.Form.Get("labels") != "" {
+			t.Errorf("labels should not be set, got %v", r.Form.Get("labels"))
+		}
+		fmt.Fprint(w, `{"id":1,"iid":5,"project_id":1,"labels":["bug","urgent"]}`)
+	})
+
+	issue, _, err := client.Issues.AddLabels(context.Background(), 1, 5, []string{"bug", "urgent"})
+	if err != nil {
+		t.Fatalf("AddLabels returned error: %v", err)
+	}
+	if len(issue.Labels) != 2 {
+		t.Errorf("AddLabels Labels = %v, want [bug urgent]", issue.Labels)
+	}
+}
+
+func TestIssuesService_RemoveLabels(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1/issues/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		r.ParseForm()
+		if got, want := r.Form.Get("remove_labels"), "bug"; got != want {
+			t.Errorf("remove_labels = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"id":1,"iid":5,"project_id":1,"labels":[]}`)
+	})
+
+	if _, _, err := client.Issues.RemoveLabels(context.Background(), 1, 5, []string{"bug"}); err != nil {
+		t.Fatalf("RemoveLabels returned error: %v", err)
+	}
+}
+
+func TestIssuesService_SetLabels(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1/issues/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		r.ParseForm()
+		if got, want := r.Form.Get("labels"), "bug"; got != want {
+			t.Errorf("labels = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"id":1,"iid":5,"project_id":1,"labels":["bug"]}`)
+	})
+
+	if _, _, err := client.Issues.SetLabels(context.Background(), 1, 5, []string{"bug"}); err != nil {
+		t.Fatalf("SetLabels returned error: %v", err)
+	}
+}
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -33,31 +34,73 @@ type LabelsService struct {
 //
 // GitLab API docs: http://doc.gitlab.com/ce/api/labels.html
 type Label struct {
-	Name  string `json:"name"`
-	Color string `json:"color"`
+	ID                     int     `json:"id"`
+	Name                   string  `json:"name"`
+	Color                  string  `json:"color"`
+	Description            *string `json:"description"`
+	OpenIssuesCount        int     `json:"open_issues_count"`
+	ClosedIssuesCount      int     `json:"closed_issues_count"`
+	OpenMergeRequestsCount int     `json:"open_merge_requests_count"`
+	Subscribed             bool    `json:"subscribed"`
+	Priority               *int    `json:"priority"`
 }
 
 func (l Label) String() string {
 	return Stringify(l)
 }
 
+// ListLabelsOptions represents the available ListLabels() options.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/labels.html#list-labels
+type ListLabelsOptions struct {
+	ListOptions
+}
+
 // ListLabels gets all labels for given project.
 //
 // GitLab API docs: http://doc.gitlab.com/ce/api/labels.html#list-labels
-func (s *LabelsService) ListLabels(pid interface{}) ([]*Label, *Response, error) {
+func (s *LabelsService) ListLabels(ctx context.Context, pid interface{}, opt *ListLabelsOptions) ([]*Label, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/labels", url.QueryEscape(project))
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequest("GET", u, opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	var l []*Label
-	resp, err := s.client.Do(req, &l)
+	resp, err := s.client.Do(ctx, req, &l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l, resp, err
+}
+
+// GetLabel gets a single label for a given project.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/labels.html#get-a-single-label
+func (s *LabelsService) GetLabel(ctx context.Context, pid interface{}, labelID interface{}) (*Label, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	label, err := parseID(labelID)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/labels/%s", url.QueryEscape(project), url.QueryEscape(label))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(Label)
+	resp, err := s.client.Do(ctx, req, l)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -69,8 +112,9 @@ func (s *LabelsService) ListLabels(pid interface{}) ([]*Label, *Response, error)
 //
 // GitLab API docs: http://doc.gitlab.com/ce/api/labels.html#create-a-new-label
 type CreateLabelOptions struct {
-	Name  string `url:"name,omitempty"`
-	Color string `url:"color,omitempty"`
+	Name        string `url:"name,omitempty"`
+	Color       string `url:"color,omitempty"`
+	Description string `url:"description,omitempty"`
 }
 
 // CreateLabel creates a new label for given repository with given name and
@@ -78,6 +122,7 @@ type CreateLabelOptions struct {
 //
 // GitLab API docs: http://doc.gitlab.com/ce/api/labels.html#create-a-new-label
 func (s *LabelsService) CreateLabel(
+	ctx context.Context,
 	pid interface{},
 	opt *CreateLabelOptions) (*Label, *Response, error) {
 	project, err := parseID(pid)
@@ -92,7 +137,7 @@ func (s *LabelsService) CreateLabel(
 	}
 
 	l := new(Label)
-	resp, err := s.client.Do(req, l)
+	resp, err := s.client.Do(ctx, req, l)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -110,7 +155,7 @@ type DeleteLabelOptions struct {
 // DeleteLabel deletes a label given by its name.
 //
 // GitLab API docs: http://doc.gitlab.com/ce/api/labels.html#delete-a-label
-func (s *LabelsService) DeleteLabel(pid interface{}, opt *DeleteLabelOptions) (*Response, error) {
+func (s *LabelsService) DeleteLabel(ctx context.Context, pid interface{}, opt *DeleteLabelOptions) (*Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, err
@@ -122,7 +167,7 @@ func (s *LabelsService) DeleteLabel(pid interface{}, opt *DeleteLabelOptions) (*
 		return nil, err
 	}
 
-	resp, err := s.client.Do(req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
 	if err != nil {
 		return resp, err
 	}
@@ -134,9 +179,10 @@ func (s *LabelsService) DeleteLabel(pid interface{}, opt *DeleteLabelOptions) (*
 //
 // GitLab API docs: http://doc.gitlab.com/ce/api/labels.html#delete-a-label
 type UpdateLabelOptions struct {
-	Name    string `url:"name,omitempty"`
-	NewName string `url:"new_name,omitempty"`
-	Color   string `url:"color,omitempty"`
+	Name        string `url:"name,omitempty"`
+	NewName     string `url:"new_name,omitempty"`
+	Color       string `url:"color,omitempty"`
+	Description string `url:"description,omitempty"`
 }
 
 // UpdateLabel updates an existing label with new name or now color. At least
@@ -144,6 +190,7 @@ type UpdateLabelOptions struct {
 //
 // GitLab API docs: http://doc.gitlab.com/ce/api/labels.html#edit-an-existing-label
 func (s *LabelsService) UpdateLabel(
+	ctx context.Context,
 	pid interface{},
 	opt *UpdateLabelOptions) (*Label, *Response, error) {
 	project, err := parseID(pid)
@@ -158,10 +205,71 @@ func (s *LabelsService) UpdateLabel(
 	}
 
 	l := new(Label)
-	resp, err := s.client.Do(req, l)
+	resp, err := s.client.Do(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l, resp, err
+}
+
+// SubscribeToLabel subscribes the authenticated user to a label to receive
+// notifications. If the user is already subscribed to the label, the status
+// code 304 is returned.
+//
+// GitLab API docs:
+// http://doc.gitlab.com/ce/api/labels.html#subscribe-to-a-label
+func (s *LabelsService) SubscribeToLabel(ctx context.Context, pid interface{}, labelID interface{}) (*Label, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	label, err := parseID(labelID)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/labels/%s/subscribe", url.QueryEscape(project), url.QueryEscape(label))
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(Label)
+	resp, err := s.client.Do(ctx, req, l)
 	if err != nil {
 		return nil, resp, err
 	}
 
 	return l, resp, err
 }
+
+// UnsubscribeFromLabel unsubscribes the authenticated user from a label to
+// stop receiving notifications from it. If the user is not subscribed to the
+// label, the status code 304 is returned.
+//
+// GitLab API docs:
+// http://doc.gitlab.com/ce/api/labels.html#unsubscribe-from-a-label
+func (s *LabelsService) UnsubscribeFromLabel(ctx context.Context, pid interface{}, labelID interface{}) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	label, err := parseID(labelID)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/labels/%s/subscribe", url.QueryEscape(project), url.QueryEscape(label))
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, err
+}
@@ -0,0 +1,165 @@
+//
+// Copyright 2015, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListLabels(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("page"), "2"; got != want {
+			t.Errorf("page = %v, want %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("per_page"), "5"; got != want {
+			t.Errorf("per_page = %v, want %v", got, want)
+		}
+		w.Header().Set("X-Next-Page", "3")
+		w.Header().Set("X-Total", "11")
+		fmt.Fprint(w, `[{"id":1,"name":"bug","color":"#f00"}]`)
+	})
+
+	labels, resp, err := client.Labels.ListLabels(context.Background(), 1, &ListLabelsOptions{ListOptions{Page: 2, PerPage: 5}})
+	if err != nil {
+		t.Fatalf("ListLabels returned error: %v", err)
+	}
+
+	if len(labels) != 1 || labels[0].Name != "bug" {
+		t.Errorf("ListLabels returned %+v, want a single \"bug\" label", labels)
+	}
+	if resp.NextPage != 3 {
+		t.Errorf("ListLabels NextPage = %d, want 3", resp.NextPage)
+	}
+	if resp.TotalItems != 11 {
+		t.Errorf("ListLabels TotalItems = %d, want 11", resp.TotalItems)
+	}
+}
+
+func TestGetLabel(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1/labels/bug", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"name":"bug","color":"#f00"}`)
+	})
+
+	label, _, err := client.Labels.GetLabel(context.Background(), 1, "bug")
+	if err != nil {
+		t.Fatalf("GetLabel returned error: %v", err)
+	}
+
+	if label.ID != 1 || label.Name != "bug" {
+		t.Errorf("GetLabel returned %+v, want {ID:1 Name:bug ...}", label)
+	}
+}
+
+func TestCreateLabel(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+		if got, want := r.Form.Get("description"), "found a bug"; got != want {
+			t.Errorf("description = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"id":1,"name":"bug","color":"#f00","description":"found a bug"}`)
+	})
+
+	label, _, err := client.Labels.CreateLabel(context.Background(), 1, &CreateLabelOptions{
+		Name:        "bug",
+		Color:       "#f00",
+		Description: "found a bug",
+	})
+	if err != nil {
+		t.Fatalf("CreateLabel returned error: %v", err)
+	}
+
+	if label.Description == nil || *label.Description != "found a bug" {
+		t.Errorf("CreateLabel Description = %v, want \"found a bug\"", label.Description)
+	}
+}
+
+func TestDeleteLabel(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Labels.DeleteLabel(context.Background(), 1, &DeleteLabelOptions{Name: "bug"})
+	if err != nil {
+		t.Fatalf("DeleteLabel returned error: %v", err)
+	}
+}
+
+func TestSubscribeToLabel(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1/labels/bug/subscribe", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":1,"name":"bug","subscribed":true}`)
+	})
+
+	label, _, err := client.Labels.SubscribeToLabel(context.Background(), 1, "bug")
+	if err != nil {
+		t.Fatalf("SubscribeToLabel returned error: %v", err)
+	}
+	if !label.Subscribed {
+		t.Error("SubscribeToLabel Subscribed = false, want true")
+	}
+}
+
+func TestSubscribeToLabel_alreadySubscribedIsNotAnError(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1/labels/bug/subscribe", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	_, _, err := client.Labels.SubscribeToLabel(context.Background(), 1, "bug")
+	if err != nil {
+		t.Errorf("SubscribeToLabel returned error: %v, want nil for a 304 response", err)
+	}
+}
+
+func TestUnsubscribeFromLabel(t *testing.T) {
+	mux, client, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1/labels/bug/subscribe", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Labels.UnsubscribeFromLabel(context.Background(), 1, "bug")
+	if err != nil {
+		t.Fatalf("UnsubscribeFromLabel returned error: %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+//
+// Copyright 2015, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// MergeRequestsService handles communication with the merge request related
+// methods of the GitLab API.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/merge_requests.html
+type MergeRequestsService struct {
+	client *Client
+}
+
+// MergeRequest represents a GitLab merge request.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/merge_requests.html
+type MergeRequest struct {
+	ID        int      `json:"id"`
+	IID       int      `json:"iid"`
+	ProjectID int      `json:"project_id"`
+	Title     string   `json:"title"`
+	Labels    []string `json:"labels"`
+}
+
+func (m MergeRequest) String() string {
+	return Stringify(m)
+}
+
+// UpdateMergeRequestLabelsOptions represents the available options for
+// updating the labels that are assigned to a merge request.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/merge_requests.html#update-mr
+type UpdateMergeRequestLabelsOptions struct {
+	Labels       []string `url:"labels,comma,omitempty"`
+	AddLabels    []string `url:"add_labels,comma,omitempty"`
+	RemoveLabels []string `url:"remove_labels,comma,omitempty"`
+}
+
+// updateMergeRequestLabels updates the labels assigned to a merge request
+// and returns the updated merge request.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/merge_requests.html#update-mr
+func (s *MergeRequestsService) updateMergeRequestLabels(ctx context.Context, pid interface{}, mergeRequestIID int, opt *UpdateMergeRequestLabelsOptions) (*MergeRequest, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d", url.QueryEscape(project), mergeRequestIID)
+
+	req, err := s.client.NewRequest("PUT", u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(MergeRequest)
+	resp, err := s.client.Do(ctx, req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+// AddLabels adds the given labels to a merge request, leaving any existing
+// labels on the merge request untouched, and returns the updated merge
+// request.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/merge_requests.html#update-mr
+func (s *MergeRequestsService) AddLabels(ctx context.Context, pid interface{}, mergeRequestIID int, labels []string) (*MergeRequest, *Response, error) {
+	return s.updateMergeRequestLabels(ctx, pid, mergeRequestIID, &UpdateMergeRequestLabelsOptions{AddLabels: labels})
+}
+
+// RemoveLabels removes the given labels from a merge request and returns the
+// updated merge request.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/merge_requests.html#update-mr
+func (s *MergeRequestsService) RemoveLabels(ctx context.Context, pid interface{}, mergeRequestIID int, labels []string) (*MergeRequest, *Response, error) {
+	return s.updateMergeRequestLabels(ctx, pid, mergeRequestIID, &UpdateMergeRequestLabelsOptions{RemoveLabels: labels})
+}
+
+// SetLabels replaces a merge request's full set of labels with the given
+// labels and returns the updated merge request.
+//
+// GitLab API docs: http://doc.gitlab.com/ce/api/merge_requests.html#update-mr
+func (s *MergeRequestsService) SetLabels(ctx context.Context, pid interface{}, mergeRequestIID int, labels []string) (*MergeRequest, *Response, error) {
+	return s.updateMergeRequestLabels(ctx, pid, mergeRequestIID, &UpdateMergeRequestLabelsOptions{Labels: labels})
+}